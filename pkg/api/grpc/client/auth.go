@@ -0,0 +1,13 @@
+package client
+
+import "context"
+
+// bearerToken implements credentials.PerRPCCredentials, attaching the
+// token as the metadata key the server's AuthFunc expects.
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"x-indexer-token": string(t)}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool { return false }
@@ -0,0 +1,150 @@
+// Package grpcapi implements pkg/api/grpc: a gRPC streaming subscription
+// service over the indexer's storage layer. It is named grpcapi, not
+// grpc, because the latter collides with google.golang.org/grpc, which
+// every file here imports.
+package grpcapi
+
+import (
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+)
+
+// SlotEvent is a live slot notification published to the hub as the
+// fetcher processes new slots. It carries the same shape as
+// storage.SlotRecord; the hub exists because subscribers need a fan-out
+// point the storage layer itself doesn't provide.
+type SlotEvent struct {
+	Slot       uint64
+	Blockhash  solana.Hash
+	ParentSlot uint64
+	Commitment storage.Commitment
+}
+
+// TxEvent is a live transaction notification published to the hub. It
+// carries ProgramIDs/Accounts alongside the opaque Data payload so the
+// hub can apply TransactionFilter without decoding the payload itself.
+type TxEvent struct {
+	Signature  solana.Signature
+	Slot       uint64
+	Data       []byte
+	ProgramIDs []solana.PublicKey
+	Accounts   []solana.PublicKey
+	Commitment storage.Commitment
+}
+
+// RollbackEvent is published to the hub when the fork tracker detects
+// and resolves a reorg; it carries the same range as fork.Rollback so
+// grpcapi doesn't need to import the fork package just for this type.
+type RollbackEvent struct {
+	FromSlot uint64
+	ToSlot   uint64
+}
+
+// Hub fans live SlotEvents, TxEvents, and RollbackEvents out to
+// subscribers. The fetcher calls PublishSlot/PublishTx as it ingests the
+// chain and PublishRollback as the fork tracker resolves reorgs;
+// Server.Subscribe* call the matching Hub.Subscribe* method to join a
+// feed.
+type Hub struct {
+	mu           sync.RWMutex
+	slotSubs     map[*ring[SlotEvent]]struct{}
+	txSubs       map[*ring[TxEvent]]struct{}
+	rollbackSubs map[*ring[RollbackEvent]]struct{}
+	ringSize     int
+}
+
+// NewHub constructs a Hub whose per-subscriber ring buffers hold
+// ringSize pending events before the subscriber is considered a slow
+// consumer and disconnected.
+func NewHub(ringSize int) *Hub {
+	if ringSize <= 0 {
+		ringSize = 256
+	}
+	return &Hub{
+		slotSubs:     map[*ring[SlotEvent]]struct{}{},
+		txSubs:       map[*ring[TxEvent]]struct{}{},
+		rollbackSubs: map[*ring[RollbackEvent]]struct{}{},
+		ringSize:     ringSize,
+	}
+}
+
+// PublishSlot fans ev out to every slot subscriber, dropping (and
+// closing) any subscriber whose ring buffer is full.
+func (h *Hub) PublishSlot(ev SlotEvent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for r := range h.slotSubs {
+		if !r.push(ev) {
+			r.closeSlow()
+		}
+	}
+}
+
+// PublishTx fans ev out to every transaction subscriber, dropping (and
+// closing) any subscriber whose ring buffer is full.
+func (h *Hub) PublishTx(ev TxEvent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for r := range h.txSubs {
+		if !r.push(ev) {
+			r.closeSlow()
+		}
+	}
+}
+
+// PublishRollback fans ev out to every rollback subscriber, dropping
+// (and closing) any subscriber whose ring buffer is full.
+func (h *Hub) PublishRollback(ev RollbackEvent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for r := range h.rollbackSubs {
+		if !r.push(ev) {
+			r.closeSlow()
+		}
+	}
+}
+
+// SubscribeSlots registers a new slot subscriber and returns it along
+// with an unsubscribe func the caller must invoke when done.
+func (h *Hub) SubscribeSlots() (*ring[SlotEvent], func()) {
+	r := newRing[SlotEvent](h.ringSize)
+	h.mu.Lock()
+	h.slotSubs[r] = struct{}{}
+	h.mu.Unlock()
+	return r, func() {
+		h.mu.Lock()
+		delete(h.slotSubs, r)
+		h.mu.Unlock()
+	}
+}
+
+// SubscribeTxs registers a new transaction subscriber and returns it
+// along with an unsubscribe func the caller must invoke when done.
+func (h *Hub) SubscribeTxs() (*ring[TxEvent], func()) {
+	r := newRing[TxEvent](h.ringSize)
+	h.mu.Lock()
+	h.txSubs[r] = struct{}{}
+	h.mu.Unlock()
+	return r, func() {
+		h.mu.Lock()
+		delete(h.txSubs, r)
+		h.mu.Unlock()
+	}
+}
+
+// SubscribeRollbacks registers a new rollback subscriber and returns it
+// along with an unsubscribe func the caller must invoke when done.
+func (h *Hub) SubscribeRollbacks() (*ring[RollbackEvent], func()) {
+	r := newRing[RollbackEvent](h.ringSize)
+	h.mu.Lock()
+	h.rollbackSubs[r] = struct{}{}
+	h.mu.Unlock()
+	return r, func() {
+		h.mu.Lock()
+		delete(h.rollbackSubs, r)
+		h.mu.Unlock()
+	}
+}
@@ -0,0 +1,45 @@
+package grpcapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHubSubscribeSlotsFanOut(t *testing.T) {
+	h := NewHub(4)
+	r1, unsub1 := h.SubscribeSlots()
+	defer unsub1()
+	r2, unsub2 := h.SubscribeSlots()
+	defer unsub2()
+
+	h.PublishSlot(SlotEvent{Slot: 42})
+
+	for _, r := range []*ring[SlotEvent]{r1, r2} {
+		ev, ok := r.pop()
+		require.True(t, ok)
+		require.Equal(t, uint64(42), ev.Slot)
+	}
+}
+
+func TestHubUnsubscribeStopsDelivery(t *testing.T) {
+	h := NewHub(4)
+	r, unsub := h.SubscribeSlots()
+	unsub()
+
+	h.PublishSlot(SlotEvent{Slot: 1})
+
+	_, ok := r.pop()
+	require.False(t, ok, "unsubscribed ring should receive nothing")
+}
+
+func TestHubSlowConsumerIsClosed(t *testing.T) {
+	h := NewHub(1)
+	r, unsub := h.SubscribeSlots()
+	defer unsub()
+
+	h.PublishSlot(SlotEvent{Slot: 1})
+	h.PublishSlot(SlotEvent{Slot: 2}) // ring is full here; hub closes it
+
+	require.True(t, r.isClosed())
+}
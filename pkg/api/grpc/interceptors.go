@@ -0,0 +1,122 @@
+package grpcapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authTokenMD is the metadata key clients must set to the configured
+// token to pass AuthFunc.
+const authTokenMD = "x-indexer-token"
+
+// AuthFunc validates a single static bearer token carried as request
+// metadata. It's intentionally simple: the indexer has one trust
+// boundary (the gRPC listener), not per-method ACLs.
+func AuthFunc(token string) func(ctx context.Context) (context.Context, error) {
+	return func(ctx context.Context) (context.Context, error) {
+		if token == "" {
+			return ctx, nil
+		}
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return ctx, status.Error(codes.Unauthenticated, "grpcapi: missing metadata")
+		}
+		vals := md.Get(authTokenMD)
+		if len(vals) != 1 || subtle.ConstantTimeCompare([]byte(vals[0]), []byte(token)) != 1 {
+			return ctx, status.Error(codes.Unauthenticated, "grpcapi: invalid token")
+		}
+		return ctx, nil
+	}
+}
+
+// ServerOptions builds the grpc.ServerOptions this package expects every
+// indexer gRPC listener to install: recovery (so a panicking handler
+// returns Internal instead of killing the process), structured request
+// logging, and, if token is non-empty, bearer-token auth.
+func ServerOptions(log *zap.Logger, token string) []grpc.ServerOption {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	recoveryOpts := []grpcrecovery.Option{
+		grpcrecovery.WithRecoveryHandlerContext(func(ctx context.Context, p interface{}) error {
+			log.Error("grpcapi: recovered panic in handler", zap.Any("panic", p))
+			return status.Error(codes.Internal, "grpcapi: internal error")
+		}),
+	}
+
+	unary := []grpc.UnaryServerInterceptor{
+		loggingUnaryInterceptor(log),
+		grpcrecovery.UnaryServerInterceptor(recoveryOpts...),
+	}
+	stream := []grpc.StreamServerInterceptor{
+		loggingStreamInterceptor(log),
+		grpcrecovery.StreamServerInterceptor(recoveryOpts...),
+	}
+	if token != "" {
+		authFunc := AuthFunc(token)
+		unary = append(unary, authUnaryInterceptor(authFunc))
+		stream = append(stream, authStreamInterceptor(authFunc))
+	}
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+}
+
+func loggingUnaryInterceptor(log *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		log.Info("grpcapi: unary call",
+			zap.String("method", info.FullMethod),
+			zap.Duration("duration", time.Since(start)),
+			zap.Error(err),
+		)
+		return resp, err
+	}
+}
+
+func loggingStreamInterceptor(log *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		log.Info("grpcapi: stream call",
+			zap.String("method", info.FullMethod),
+			zap.Duration("duration", time.Since(start)),
+			zap.Error(err),
+		)
+		return err
+	}
+}
+
+func authUnaryInterceptor(authFunc func(ctx context.Context) (context.Context, error)) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authFunc(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func authStreamInterceptor(authFunc func(ctx context.Context) (context.Context, error)) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authFunc(ss.Context())
+		if err != nil {
+			return err
+		}
+		wrapped := grpcmiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
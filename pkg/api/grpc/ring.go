@@ -0,0 +1,115 @@
+package grpcapi
+
+import (
+	"context"
+	"sync"
+)
+
+// ring is a bounded, single-producer/single-consumer-per-reader ring
+// buffer used as each Hub subscriber's backpressure boundary: if a
+// subscriber can't keep up, push reports failure instead of blocking the
+// publisher, and the hub disconnects the slow consumer rather than let
+// one stalled client stall the whole feed.
+type ring[T any] struct {
+	mu     sync.Mutex
+	buf    []T
+	head   int // next slot to read
+	tail   int // next slot to write
+	count  int
+	notify chan struct{} // signaled (non-blocking) on push and close
+	closed bool
+}
+
+func newRing[T any](size int) *ring[T] {
+	return &ring[T]{
+		buf:    make([]T, size),
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// push appends v, reporting false if the ring is already full. The
+// caller (Hub) treats a false return as "slow consumer" and closes r.
+func (r *ring[T]) push(v T) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed || r.count == len(r.buf) {
+		return false
+	}
+	r.buf[r.tail] = v
+	r.tail = (r.tail + 1) % len(r.buf)
+	r.count++
+	r.signal()
+	return true
+}
+
+// pop removes and returns the oldest buffered value, if any.
+func (r *ring[T]) pop() (T, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.count == 0 {
+		var zero T
+		return zero, false
+	}
+	v := r.buf[r.head]
+	var zero T
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.count--
+	return v, true
+}
+
+// wait returns a channel that receives a value whenever push or
+// closeSlow may have changed ring state; the caller re-checks pop/closed
+// after each signal.
+func (r *ring[T]) wait() <-chan struct{} {
+	return r.notify
+}
+
+func (r *ring[T]) signal() {
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+}
+
+// closeSlow marks r as closed because its consumer fell behind; a
+// subsequent pop on an empty, closed ring signals the stream handler to
+// disconnect with a slow-consumer error.
+func (r *ring[T]) closeSlow() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return
+	}
+	r.closed = true
+	r.signal()
+}
+
+func (r *ring[T]) isClosed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.closed
+}
+
+// Recv blocks until a value is available, the subscription is closed
+// because its consumer fell behind, or ctx is done. It is the
+// synchronous counterpart to wait/pop/isClosed for callers outside
+// grpcapi (tests, in particular) that just want the next event without
+// replicating that loop themselves.
+func (r *ring[T]) Recv(ctx context.Context) (T, error) {
+	for {
+		if v, ok := r.pop(); ok {
+			return v, nil
+		}
+		if r.isClosed() {
+			var zero T
+			return zero, ErrSlowConsumer
+		}
+		select {
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		case <-r.wait():
+		}
+	}
+}
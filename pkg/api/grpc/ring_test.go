@@ -0,0 +1,38 @@
+package grpcapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRingPushPop(t *testing.T) {
+	r := newRing[int](2)
+	require.True(t, r.push(1))
+	require.True(t, r.push(2))
+	require.False(t, r.push(3), "ring should reject pushes once full")
+
+	v, ok := r.pop()
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+
+	require.True(t, r.push(3), "popping should free a slot")
+
+	v, ok = r.pop()
+	require.True(t, ok)
+	require.Equal(t, 2, v)
+	v, ok = r.pop()
+	require.True(t, ok)
+	require.Equal(t, 3, v)
+
+	_, ok = r.pop()
+	require.False(t, ok, "ring should be empty")
+}
+
+func TestRingCloseSlow(t *testing.T) {
+	r := newRing[int](1)
+	require.False(t, r.isClosed())
+	r.closeSlow()
+	require.True(t, r.isClosed())
+	require.False(t, r.push(1), "closed ring should reject further pushes")
+}
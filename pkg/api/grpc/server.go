@@ -0,0 +1,263 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	indexerv1 "github.com/Layr-Labs/solana-chain-indexer/proto/indexer/v1"
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+)
+
+// ErrSlowConsumer is surfaced to a streaming client whose per-subscriber
+// ring buffer filled up before it could be drained.
+var ErrSlowConsumer = status.Error(codes.ResourceExhausted, "grpcapi: slow consumer disconnected")
+
+// Server implements indexerv1.IndexerServiceServer over a storage.Store
+// for historical replay and a Hub for the live tail. Register it with
+// indexerv1.RegisterIndexerServiceServer.
+type Server struct {
+	indexerv1.UnimplementedIndexerServiceServer
+
+	store storage.Store
+	hub   *Hub
+	log   *zap.Logger
+}
+
+// NewServer constructs a Server. hub must be the same Hub the fetcher
+// publishes live events to.
+func NewServer(store storage.Store, hub *Hub, log *zap.Logger) *Server {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &Server{store: store, hub: hub, log: log}
+}
+
+func commitmentFromProto(c indexerv1.Commitment) storage.Commitment {
+	switch c {
+	case indexerv1.Commitment_COMMITMENT_FINALIZED:
+		return storage.CommitmentFinalized
+	case indexerv1.Commitment_COMMITMENT_CONFIRMED:
+		return storage.CommitmentConfirmed
+	default:
+		return storage.CommitmentProcessed
+	}
+}
+
+func slotEventToProto(ev SlotEvent) *indexerv1.SlotEvent {
+	return &indexerv1.SlotEvent{
+		Slot:       ev.Slot,
+		Blockhash:  ev.Blockhash.String(),
+		ParentSlot: ev.ParentSlot,
+		Commitment: commitmentToProto(ev.Commitment),
+	}
+}
+
+func commitmentToProto(c storage.Commitment) indexerv1.Commitment {
+	switch c {
+	case storage.CommitmentFinalized:
+		return indexerv1.Commitment_COMMITMENT_FINALIZED
+	case storage.CommitmentConfirmed:
+		return indexerv1.Commitment_COMMITMENT_CONFIRMED
+	default:
+		return indexerv1.Commitment_COMMITMENT_PROCESSED
+	}
+}
+
+// SubscribeSlots implements indexerv1.IndexerServiceServer. If
+// req.SinceSlot is non-zero it first replays stored slots strictly after
+// SinceSlot from the store before switching to the live feed published
+// to the Hub.
+func (s *Server) SubscribeSlots(req *indexerv1.SubscribeSlotsRequest, stream indexerv1.IndexerService_SubscribeSlotsServer) error {
+	ctx := stream.Context()
+	minCommitment := commitmentFromProto(req.Commitment)
+
+	r, unsubscribe := s.hub.SubscribeSlots()
+	defer unsubscribe()
+
+	lastSent := req.SinceSlot
+	if req.SinceSlot > 0 {
+		err := s.store.RangeSlots(ctx, req.SinceSlot+1, ^uint64(0), func(rec *storage.SlotRecord) error {
+			if rec.Commitment < minCommitment {
+				return nil
+			}
+			lastSent = rec.Slot
+			return stream.Send(&indexerv1.SlotEvent{
+				Slot:       rec.Slot,
+				Blockhash:  rec.Blockhash.String(),
+				ParentSlot: rec.ParentSlot,
+				Commitment: commitmentToProto(rec.Commitment),
+			})
+		})
+		if err != nil {
+			return errors.Wrap(err, "grpcapi: replay slots")
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-r.wait():
+			for {
+				ev, ok := r.pop()
+				if !ok {
+					if r.isClosed() {
+						return ErrSlowConsumer
+					}
+					break
+				}
+				if ev.Slot <= lastSent || ev.Commitment < minCommitment {
+					continue
+				}
+				if err := stream.Send(slotEventToProto(ev)); err != nil {
+					return err
+				}
+				lastSent = ev.Slot
+			}
+		}
+	}
+}
+
+func matchesFilter(ev TxEvent, f *indexerv1.TransactionFilter) bool {
+	if f == nil {
+		return true
+	}
+	if ev.Commitment < commitmentFromProto(f.Commitment) {
+		return false
+	}
+	if len(f.ProgramIds) > 0 && !anyBase58Match(ev.ProgramIDs, f.ProgramIds) {
+		return false
+	}
+	if len(f.Accounts) > 0 && !anyBase58Match(ev.Accounts, f.Accounts) {
+		return false
+	}
+	return true
+}
+
+func anyBase58Match(keys []solana.PublicKey, want []string) bool {
+	for _, k := range keys {
+		ks := k.String()
+		for _, w := range want {
+			if ks == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SubscribeTransactions implements indexerv1.IndexerServiceServer. If
+// req.SinceSlot is non-zero it first replays every stored transaction
+// strictly after SinceSlot before switching to the live feed. req.Filter
+// is applied to the live feed only: stored TxRecords carry no program
+// IDs, accounts or commitment, so replayed transactions are not filtered.
+func (s *Server) SubscribeTransactions(req *indexerv1.SubscribeTransactionsRequest, stream indexerv1.IndexerService_SubscribeTransactionsServer) error {
+	ctx := stream.Context()
+
+	r, unsubscribe := s.hub.SubscribeTxs()
+	defer unsubscribe()
+
+	lastSlot := req.SinceSlot
+	if req.SinceSlot > 0 {
+		err := s.store.RangeTxs(ctx, req.SinceSlot+1, ^uint64(0), func(rec *storage.TxRecord) error {
+			lastSlot = rec.Slot
+			return stream.Send(&indexerv1.TransactionEvent{
+				Signature: rec.Signature.String(),
+				Slot:      rec.Slot,
+				Data:      rec.Data,
+			})
+		})
+		if err != nil {
+			return errors.Wrap(err, "grpcapi: replay transactions")
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-r.wait():
+			for {
+				ev, ok := r.pop()
+				if !ok {
+					if r.isClosed() {
+						return ErrSlowConsumer
+					}
+					break
+				}
+				if ev.Slot <= lastSlot || !matchesFilter(ev, req.Filter) {
+					continue
+				}
+				if err := stream.Send(&indexerv1.TransactionEvent{
+					Signature: ev.Signature.String(),
+					Slot:      ev.Slot,
+					Data:      ev.Data,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// SubscribeRollbacks implements indexerv1.IndexerServiceServer. It is
+// live-only: the storage layer doesn't keep a log of past rollbacks, so
+// unlike SubscribeSlots/SubscribeTransactions there is nothing to replay
+// for req.SinceSlot. It only filters the live feed: rollbacks whose
+// ToSlot is at or below req.SinceSlot are skipped.
+func (s *Server) SubscribeRollbacks(req *indexerv1.SubscribeRollbacksRequest, stream indexerv1.IndexerService_SubscribeRollbacksServer) error {
+	ctx := stream.Context()
+
+	r, unsubscribe := s.hub.SubscribeRollbacks()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-r.wait():
+			for {
+				ev, ok := r.pop()
+				if !ok {
+					if r.isClosed() {
+						return ErrSlowConsumer
+					}
+					break
+				}
+				if ev.ToSlot <= req.SinceSlot {
+					continue
+				}
+				if err := stream.Send(&indexerv1.RollbackEvent{FromSlot: ev.FromSlot, ToSlot: ev.ToSlot}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// GetTransaction implements indexerv1.IndexerServiceServer.
+func (s *Server) GetTransaction(ctx context.Context, req *indexerv1.GetTransactionRequest) (*indexerv1.GetTransactionResponse, error) {
+	sig, err := solana.SignatureFromBase58(req.Signature)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "grpcapi: malformed signature")
+	}
+	rec, err := s.store.GetTx(ctx, sig)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "grpcapi: transaction not found")
+		}
+		return nil, status.Error(codes.Internal, "grpcapi: lookup transaction")
+	}
+	return &indexerv1.GetTransactionResponse{
+		Transaction: &indexerv1.TransactionEvent{
+			Signature: rec.Signature.String(),
+			Slot:      rec.Slot,
+			Data:      rec.Data,
+		},
+	}, nil
+}
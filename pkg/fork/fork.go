@@ -0,0 +1,177 @@
+// Package fork tracks the chain of processed slots as a DAG keyed by
+// (slot, blockhash, parent_blockhash) instead of a flat slot number, so
+// it can detect when a newly observed block doesn't extend the
+// previously canonical chain and compute exactly which slots a
+// reorganization orphaned.
+package fork
+
+import (
+	"github.com/gagliardetto/solana-go"
+	"github.com/pkg/errors"
+
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+)
+
+// ErrFinalizedRollback is returned by Observe if a detected fork's
+// common ancestor is strictly below the tracker's finalized slot, so the
+// orphaned range would include a finalized slot: Solana's
+// finality guarantee means this should never legitimately happen, and
+// the tracker refuses to roll back immutable history.
+var ErrFinalizedRollback = errors.New("fork: rollback would affect a finalized slot")
+
+// Block is one observed (slot, blockhash, parent_blockhash) triple, the
+// unit the tracker's DAG is built from.
+type Block struct {
+	Slot       uint64
+	Blockhash  solana.Hash
+	ParentHash solana.Hash
+	Commitment storage.Commitment
+}
+
+// Rollback describes a contiguous range of previously canonical slots
+// that a fork superseded. [FromSlot, ToSlot] is inclusive; both ends are
+// strictly greater than the common ancestor's slot.
+type Rollback struct {
+	FromSlot uint64
+	ToSlot   uint64
+}
+
+type node struct {
+	slot       uint64
+	hash       solana.Hash
+	parent     solana.Hash
+	commitment storage.Commitment
+}
+
+// Tracker maintains the canonical chain and detects forks as new blocks
+// are observed. It is not safe for concurrent use; callers serialize
+// calls the way the rest of the pipeline serializes slot processing.
+type Tracker struct {
+	nodes         map[solana.Hash]*node
+	canonical     map[uint64]solana.Hash
+	tip           uint64 // highest slot ever made canonical
+	finalizedSlot uint64
+	haveFinalized bool
+}
+
+// NewTracker constructs an empty Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{
+		nodes:     map[solana.Hash]*node{},
+		canonical: map[uint64]solana.Hash{},
+	}
+}
+
+// Observe records a newly fetched block. If it extends the current
+// canonical chain (its ParentHash matches the canonical block at Slot-1,
+// and no different block is already canonical at Slot), it simply
+// becomes canonical and Observe returns a nil Rollback. If a different
+// block is already canonical at Slot, b is a sibling proposed for the
+// same slot; Slot-1 is the common ancestor and b supersedes it directly.
+// Otherwise b forks off an ancestor deeper than Slot-1, and Observe walks
+// backward to find the common ancestor with the current canonical chain.
+// In both fork cases b's branch becomes canonical from the ancestor
+// forward and Observe returns the Rollback describing the orphaned
+// range.
+func (t *Tracker) Observe(b Block) (*Rollback, error) {
+	t.nodes[b.Blockhash] = &node{slot: b.Slot, hash: b.Blockhash, parent: b.ParentHash, commitment: b.Commitment}
+
+	existingParent, haveParent := t.canonical[b.Slot-1]
+	parentMatches := b.Slot == 0 || !haveParent || existingParent == b.ParentHash
+
+	existingAtSlot, haveAtSlot := t.canonical[b.Slot]
+	if parentMatches && (!haveAtSlot || existingAtSlot == b.Blockhash) {
+		t.canonical[b.Slot] = b.Blockhash
+		if b.Slot > t.tip {
+			t.tip = b.Slot
+		}
+		return nil, nil
+	}
+
+	if parentMatches {
+		// A sibling block for an already-canonical slot: two blocks
+		// proposed for the same slot, both extending the canonical
+		// parent at Slot-1. That parent is the common ancestor, so
+		// there's nothing to walk back through.
+		ancestorSlot := b.Slot - 1
+		if t.haveFinalized && ancestorSlot < t.finalizedSlot {
+			return nil, ErrFinalizedRollback
+		}
+		oldTip := t.tip
+		t.canonical[b.Slot] = b.Blockhash
+		if b.Slot > t.tip {
+			t.tip = b.Slot
+		}
+		t.orphanDescendants(b.Slot, oldTip)
+		return &Rollback{FromSlot: b.Slot, ToSlot: oldTip}, nil
+	}
+
+	// b forks off an already-canonical ancestor. Walk b's parent chain
+	// back until we reach a block that is canonical at its own slot:
+	// that's the common ancestor.
+	var newChain []*node
+	cur, ok := t.nodes[b.ParentHash]
+	for ok {
+		if t.canonical[cur.slot] == cur.hash {
+			break
+		}
+		newChain = append(newChain, cur)
+		cur, ok = t.nodes[cur.parent]
+	}
+	if !ok {
+		return nil, errors.Errorf("fork: no known common ancestor for block at slot %d", b.Slot)
+	}
+	ancestorSlot := cur.slot
+
+	if t.haveFinalized && ancestorSlot < t.finalizedSlot {
+		return nil, ErrFinalizedRollback
+	}
+
+	oldTip := t.tip
+
+	// Replay the new chain forward onto canonical, then add b itself.
+	for i := len(newChain) - 1; i >= 0; i-- {
+		t.canonical[newChain[i].slot] = newChain[i].hash
+	}
+	t.canonical[b.Slot] = b.Blockhash
+	if b.Slot > t.tip {
+		t.tip = b.Slot
+	}
+	t.orphanDescendants(b.Slot, oldTip)
+
+	if oldTip <= ancestorSlot {
+		return nil, nil
+	}
+	return &Rollback{FromSlot: ancestorSlot + 1, ToSlot: oldTip}, nil
+}
+
+// orphanDescendants removes canonical entries for slots in
+// (upTo, oldTip] left behind by a rollback that only replayed the chain
+// through upTo. Those slots belonged to the now-superseded branch and no
+// replacement has been observed yet; leaving their stale entries in place
+// would make the next legitimate block for that slot look like another
+// conflicting sibling instead of a normal chain extension.
+func (t *Tracker) orphanDescendants(upTo, oldTip uint64) {
+	for slot := upTo + 1; slot <= oldTip; slot++ {
+		delete(t.canonical, slot)
+	}
+}
+
+// Finalize advances the tracker's finalized boundary to slot. Slots at
+// or below it are treated as immutable: Observe refuses any rollback
+// that would reach back past it, and callers use Finalize's return value
+// to decide which slots may now be promoted into the cold archival tier.
+func (t *Tracker) Finalize(slot uint64) error {
+	if t.haveFinalized && slot < t.finalizedSlot {
+		return errors.Errorf("fork: finalized slot must be non-decreasing (have %d, got %d)", t.finalizedSlot, slot)
+	}
+	t.finalizedSlot = slot
+	t.haveFinalized = true
+	return nil
+}
+
+// FinalizedSlot returns the highest slot Finalize has been called with,
+// and whether Finalize has been called at all.
+func (t *Tracker) FinalizedSlot() (uint64, bool) {
+	return t.finalizedSlot, t.haveFinalized
+}
@@ -0,0 +1,71 @@
+package fork
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+)
+
+func hash(n byte) solana.Hash {
+	var h solana.Hash
+	h[0] = n
+	return h
+}
+
+func TestTrackerLinearChainNoRollback(t *testing.T) {
+	tr := NewTracker()
+
+	rb, err := tr.Observe(Block{Slot: 1, Blockhash: hash(1), ParentHash: hash(0), Commitment: storage.CommitmentConfirmed})
+	require.NoError(t, err)
+	require.Nil(t, rb)
+
+	rb, err = tr.Observe(Block{Slot: 2, Blockhash: hash(2), ParentHash: hash(1), Commitment: storage.CommitmentConfirmed})
+	require.NoError(t, err)
+	require.Nil(t, rb)
+}
+
+func TestTrackerDetectsFork(t *testing.T) {
+	tr := NewTracker()
+	_, err := tr.Observe(Block{Slot: 1, Blockhash: hash(1), ParentHash: hash(0)})
+	require.NoError(t, err)
+	_, err = tr.Observe(Block{Slot: 2, Blockhash: hash(2), ParentHash: hash(1)})
+	require.NoError(t, err)
+	_, err = tr.Observe(Block{Slot: 3, Blockhash: hash(3), ParentHash: hash(2)})
+	require.NoError(t, err)
+
+	// A competing block at slot 2 that forks off slot 1 (the common
+	// ancestor) should roll back everything canonical past it: [2, 3].
+	rb, err := tr.Observe(Block{Slot: 2, Blockhash: hash(22), ParentHash: hash(1)})
+	require.NoError(t, err)
+	require.NotNil(t, rb)
+	require.Equal(t, Rollback{FromSlot: 2, ToSlot: 3}, *rb)
+
+	// Its successor now simply extends the newly-canonical chain.
+	rb, err = tr.Observe(Block{Slot: 3, Blockhash: hash(33), ParentHash: hash(22)})
+	require.NoError(t, err)
+	require.Nil(t, rb)
+}
+
+func TestTrackerRefusesFinalizedRollback(t *testing.T) {
+	tr := NewTracker()
+	_, err := tr.Observe(Block{Slot: 1, Blockhash: hash(1), ParentHash: hash(0)})
+	require.NoError(t, err)
+	_, err = tr.Observe(Block{Slot: 2, Blockhash: hash(2), ParentHash: hash(1)})
+	require.NoError(t, err)
+	require.NoError(t, tr.Finalize(2))
+
+	// Forking off slot 1 (at or below the finalized boundary) must be
+	// refused: Solana's finality guarantee means this should never
+	// legitimately happen.
+	_, err = tr.Observe(Block{Slot: 2, Blockhash: hash(22), ParentHash: hash(1)})
+	require.ErrorIs(t, err, ErrFinalizedRollback)
+}
+
+func TestTrackerFinalizeRejectsDecreasing(t *testing.T) {
+	tr := NewTracker()
+	require.NoError(t, tr.Finalize(10))
+	require.Error(t, tr.Finalize(5))
+}
@@ -0,0 +1,30 @@
+package fork
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+)
+
+// Apply deletes every slot and transaction in rb's range from store. It's
+// the storage-layer side effect of a detected fork: callers run it after
+// Observe returns a non-nil Rollback and before forwarding the Rollback
+// to gRPC subscribers, so a client that reconnects mid-rollback never
+// observes the orphaned data.
+func Apply(ctx context.Context, store storage.Store, rb Rollback) error {
+	if err := store.RangeTxs(ctx, rb.FromSlot, rb.ToSlot, func(rec *storage.TxRecord) error {
+		return store.DeleteTx(ctx, rec.Signature)
+	}); err != nil {
+		return errors.Wrap(err, "fork: delete orphaned transactions")
+	}
+
+	if err := store.RangeSlots(ctx, rb.FromSlot, rb.ToSlot, func(rec *storage.SlotRecord) error {
+		return store.DeleteSlot(ctx, rec.Slot)
+	}); err != nil {
+		return errors.Wrap(err, "fork: delete orphaned slots")
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package fork
+
+import "context"
+
+// BlockRPC is the subset of the Solana RPC surface the fork watcher
+// depends on. Narrowed to two methods so tests can script fork scenarios
+// against a mock instead of a live RPC endpoint.
+type BlockRPC interface {
+	// GetBlock fetches the block at slot. Implementations return
+	// storage.ErrNotFound (or an error wrapping it) for a skipped slot.
+	GetBlock(ctx context.Context, slot uint64) (*Block, error)
+	// GetFinalizedSlot returns the RPC endpoint's current finalized
+	// commitment slot.
+	GetFinalizedSlot(ctx context.Context) (uint64, error)
+}
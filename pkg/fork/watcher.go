@@ -0,0 +1,96 @@
+package fork
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	grpcapi "github.com/Layr-Labs/solana-chain-indexer/pkg/api/grpc"
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+)
+
+// Watcher drives a Tracker from a BlockRPC: it fetches slots in order,
+// feeds each block to the tracker, applies any resulting Rollback to
+// Store, and publishes both ordinary slot events and rollbacks to Hub
+// for gRPC subscribers.
+type Watcher struct {
+	rpc     BlockRPC
+	tracker *Tracker
+	store   storage.Store
+	hub     *grpcapi.Hub
+	log     *zap.Logger
+}
+
+// NewWatcher constructs a Watcher over an empty Tracker.
+func NewWatcher(rpc BlockRPC, store storage.Store, hub *grpcapi.Hub, log *zap.Logger) *Watcher {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	return &Watcher{rpc: rpc, tracker: NewTracker(), store: store, hub: hub, log: log}
+}
+
+// ProcessSlot fetches the block at slot, runs it through the fork
+// tracker, applies any rollback the tracker detects, and persists and
+// publishes the new canonical block. It returns the Rollback the
+// tracker detected, if any.
+func (w *Watcher) ProcessSlot(ctx context.Context, slot uint64) (*Rollback, error) {
+	block, err := w.rpc.GetBlock(ctx, slot)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil // skipped slot
+		}
+		return nil, errors.Wrap(err, "fork: fetch block")
+	}
+
+	rb, err := w.tracker.Observe(*block)
+	if err != nil {
+		return nil, errors.Wrap(err, "fork: observe block")
+	}
+
+	if rb != nil {
+		if err := Apply(ctx, w.store, *rb); err != nil {
+			return nil, errors.Wrap(err, "fork: apply rollback")
+		}
+		w.log.Warn("fork: rollback detected",
+			zap.Uint64("from_slot", rb.FromSlot), zap.Uint64("to_slot", rb.ToSlot))
+		w.hub.PublishRollback(grpcapi.RollbackEvent{FromSlot: rb.FromSlot, ToSlot: rb.ToSlot})
+	}
+
+	parentSlot := uint64(0)
+	if block.Slot > 0 {
+		parentSlot = block.Slot - 1
+	}
+	if err := w.store.PutSlot(ctx, &storage.SlotRecord{
+		Slot:       block.Slot,
+		Blockhash:  block.Blockhash,
+		ParentSlot: parentSlot,
+		ParentHash: block.ParentHash,
+		Commitment: block.Commitment,
+	}); err != nil {
+		return nil, errors.Wrap(err, "fork: persist slot")
+	}
+	w.hub.PublishSlot(grpcapi.SlotEvent{
+		Slot:       block.Slot,
+		Blockhash:  block.Blockhash,
+		ParentSlot: parentSlot,
+		Commitment: block.Commitment,
+	})
+
+	return rb, nil
+}
+
+// SyncFinalized advances the tracker's finalized boundary to the RPC's
+// current finalized slot. Callers that also run a tiered storage layer
+// should follow a successful call with store.Advance(finalized) so
+// newly-finalized slots become eligible for cold-tier migration.
+func (w *Watcher) SyncFinalized(ctx context.Context) (uint64, error) {
+	finalized, err := w.rpc.GetFinalizedSlot(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "fork: fetch finalized slot")
+	}
+	if err := w.tracker.Finalize(finalized); err != nil {
+		return 0, err
+	}
+	return finalized, nil
+}
@@ -0,0 +1,96 @@
+package fork
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	grpcapi "github.com/Layr-Labs/solana-chain-indexer/pkg/api/grpc"
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+	"github.com/Layr-Labs/solana-chain-indexer/storage/badger"
+)
+
+func TestWatcherAppliesRollbackToStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := badger.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer store.Close()
+
+	hub := grpcapi.NewHub(4)
+	rollbacks, unsub := hub.SubscribeRollbacks()
+	defer unsub()
+
+	ctrl := gomock.NewController(t)
+	rpc := NewMockBlockRPC(ctrl)
+	w := NewWatcher(rpc, store, hub, nil)
+
+	rpc.EXPECT().GetBlock(ctx, uint64(1)).Return(&Block{Slot: 1, Blockhash: hash(1), ParentHash: hash(0)}, nil)
+	rpc.EXPECT().GetBlock(ctx, uint64(2)).Return(&Block{Slot: 2, Blockhash: hash(2), ParentHash: hash(1)}, nil)
+
+	for slot := uint64(1); slot <= 2; slot++ {
+		rb, err := w.ProcessSlot(ctx, slot)
+		require.NoError(t, err)
+		require.Nil(t, rb)
+	}
+	_, err = store.GetSlot(ctx, 2)
+	require.NoError(t, err, "slot 2 should be persisted before the fork")
+
+	// A competing block forks slot 2 off slot 1; the watcher should
+	// delete the orphaned slot 2 and publish a RollbackEvent.
+	rpc.EXPECT().GetBlock(ctx, uint64(2)).Return(&Block{Slot: 2, Blockhash: hash(22), ParentHash: hash(1)}, nil)
+	rb, err := w.ProcessSlot(ctx, 2)
+	require.NoError(t, err)
+	require.NotNil(t, rb)
+	require.Equal(t, Rollback{FromSlot: 2, ToSlot: 2}, *rb)
+
+	_, err = store.GetSlot(ctx, 2)
+	require.NoError(t, err, "slot 2 should be re-populated by the forked block")
+	rec, err := store.GetSlot(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, hash(22), rec.Blockhash)
+
+	ev, err := rollbacks.Recv(ctx)
+	require.NoError(t, err)
+	require.Equal(t, grpcapi.RollbackEvent{FromSlot: 2, ToSlot: 2}, ev)
+}
+
+func TestWatcherSkipsMissingSlot(t *testing.T) {
+	ctx := context.Background()
+	store, err := badger.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer store.Close()
+
+	hub := grpcapi.NewHub(4)
+	ctrl := gomock.NewController(t)
+	rpc := NewMockBlockRPC(ctrl)
+	w := NewWatcher(rpc, store, hub, nil)
+
+	rpc.EXPECT().GetBlock(ctx, uint64(5)).Return(nil, storage.ErrNotFound)
+
+	rb, err := w.ProcessSlot(ctx, 5)
+	require.NoError(t, err)
+	require.Nil(t, rb)
+}
+
+func TestWatcherSyncFinalized(t *testing.T) {
+	ctx := context.Background()
+	store, err := badger.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer store.Close()
+
+	hub := grpcapi.NewHub(4)
+	ctrl := gomock.NewController(t)
+	rpc := NewMockBlockRPC(ctrl)
+	w := NewWatcher(rpc, store, hub, nil)
+
+	rpc.EXPECT().GetFinalizedSlot(ctx).Return(uint64(10), nil)
+	finalized, err := w.SyncFinalized(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), finalized)
+
+	got, ok := w.tracker.FinalizedSlot()
+	require.True(t, ok)
+	require.Equal(t, uint64(10), got)
+}
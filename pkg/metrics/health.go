@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Progress tracks the ingestion pipeline's forward motion: the fetcher
+// calls RecordFetch on every loop iteration and RecordProcessed as it
+// ingests slots, and Server's /healthz and /readyz handlers read it back
+// to decide liveness and readiness.
+type Progress struct {
+	lastFetchUnixNano atomic.Int64
+	processedSlot     atomic.Uint64
+	tipSlot           atomic.Uint64
+
+	livenessDeadline time.Duration
+	readinessSlotLag uint64
+}
+
+// NewProgress constructs a Progress. livenessDeadline is how long the
+// fetcher loop may go without calling RecordFetch before /healthz fails.
+// readinessSlotLag is how far behind the RPC tip the highest processed
+// slot may be before /readyz fails.
+func NewProgress(livenessDeadline time.Duration, readinessSlotLag uint64) *Progress {
+	p := &Progress{
+		livenessDeadline: livenessDeadline,
+		readinessSlotLag: readinessSlotLag,
+	}
+	p.lastFetchUnixNano.Store(time.Now().UnixNano())
+	return p
+}
+
+// RecordFetch marks that the fetcher loop made progress just now.
+func (p *Progress) RecordFetch() {
+	p.lastFetchUnixNano.Store(time.Now().UnixNano())
+}
+
+// RecordProcessed records the highest slot the indexer has processed and
+// the RPC endpoint's reported tip as of that same observation.
+func (p *Progress) RecordProcessed(processedSlot, tipSlot uint64) {
+	p.processedSlot.Store(processedSlot)
+	p.tipSlot.Store(tipSlot)
+}
+
+// Live reports whether the fetcher loop has made progress within
+// livenessDeadline. A non-nil error is the reason it hasn't.
+func (p *Progress) Live() error {
+	last := time.Unix(0, p.lastFetchUnixNano.Load())
+	if since := time.Since(last); since > p.livenessDeadline {
+		return fmt.Errorf("metrics: fetcher loop stalled for %s (deadline %s)", since, p.livenessDeadline)
+	}
+	return nil
+}
+
+// Ready reports whether the highest processed slot is within
+// readinessSlotLag of the RPC tip. A non-nil error is the reason it
+// isn't.
+func (p *Progress) Ready() error {
+	tip := p.tipSlot.Load()
+	processed := p.processedSlot.Load()
+	if tip > processed && tip-processed > p.readinessSlotLag {
+		return fmt.Errorf("metrics: slot lag %d exceeds threshold %d (processed=%d tip=%d)", tip-processed, p.readinessSlotLag, processed, tip)
+	}
+	return nil
+}
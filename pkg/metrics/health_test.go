@@ -0,0 +1,31 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressLive(t *testing.T) {
+	p := NewProgress(50*time.Millisecond, 10)
+	require.NoError(t, p.Live())
+
+	time.Sleep(75 * time.Millisecond)
+	require.Error(t, p.Live(), "stalled fetcher loop should fail liveness")
+
+	p.RecordFetch()
+	require.NoError(t, p.Live(), "a fresh fetch should restore liveness")
+}
+
+func TestProgressReady(t *testing.T) {
+	p := NewProgress(time.Minute, 5)
+	p.RecordProcessed(100, 100)
+	require.NoError(t, p.Ready())
+
+	p.RecordProcessed(95, 100)
+	require.NoError(t, p.Ready(), "lag at the threshold should still be ready")
+
+	p.RecordProcessed(80, 100)
+	require.Error(t, p.Ready(), "lag beyond threshold should fail readiness")
+}
@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// classFieldKey is the zap field name components use to tag which class
+// of error an Error-level log entry represents (e.g. "rpc_fetch",
+// "decode", "storage_write"). Entries without it are counted as
+// "unclassified".
+const classFieldKey = "class"
+
+// WrapLogger returns a *zap.Logger that behaves exactly like base except
+// every Error-level (and above) entry also increments m.LogErrors,
+// labeled by the entry's "class" field, so operators get pipeline error
+// counts on the same dashboard as everything else in Metrics.
+func WrapLogger(base *zap.Logger, m *Metrics) *zap.Logger {
+	return base.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &metricCore{Core: core, errors: m.LogErrors}
+	}))
+}
+
+type metricCore struct {
+	zapcore.Core
+	errors *prometheus.CounterVec
+}
+
+func (c *metricCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Core.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *metricCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	if ent.Level >= zapcore.ErrorLevel {
+		class := "unclassified"
+		for _, f := range fields {
+			if f.Key == classFieldKey && f.Type == zapcore.StringType {
+				class = f.String
+				break
+			}
+		}
+		c.errors.WithLabelValues(class).Inc()
+	}
+	return c.Core.Write(ent, fields)
+}
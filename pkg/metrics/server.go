@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+// LivenessChecker reports whether the pipeline is still making forward
+// progress. Live returns a non-nil error describing why when it isn't.
+type LivenessChecker interface {
+	Live() error
+}
+
+// ReadinessChecker reports whether the pipeline is caught up enough to
+// serve traffic. Ready returns a non-nil error describing why when it
+// isn't.
+type ReadinessChecker interface {
+	Ready() error
+}
+
+// Server exposes /metrics, /healthz, and /readyz on a configurable addr.
+type Server struct {
+	http *http.Server
+	log  *zap.Logger
+}
+
+// NewServer builds a Server. reg is the registry /metrics serves (nil
+// defaults to prometheus.DefaultRegisterer's gatherer); live and ready
+// back /healthz and /readyz respectively.
+func NewServer(addr string, reg *prometheus.Registry, live LivenessChecker, ready ReadinessChecker, log *zap.Logger) *Server {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	mux := http.NewServeMux()
+	if reg != nil {
+		mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	} else {
+		mux.Handle("/metrics", promhttp.Handler())
+	}
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		if err := live.Live(); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if err := ready.Ready(); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	return &Server{
+		http: &http.Server{Addr: addr, Handler: mux},
+		log:  log,
+	}
+}
+
+// ListenAndServe blocks serving until ctx is canceled, then shuts down
+// gracefully.
+func (s *Server) ListenAndServe(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() { errCh <- s.http.ListenAndServe() }()
+	select {
+	case <-ctx.Done():
+		return s.http.Shutdown(context.Background())
+	case err := <-errCh:
+		if err == http.ErrServerClosed {
+			return nil
+		}
+		return err
+	}
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeChecker struct{ err error }
+
+func (f fakeChecker) Live() error  { return f.err }
+func (f fakeChecker) Ready() error { return f.err }
+
+func TestServerHealthzReadyz(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := New()
+	require.NoError(t, m.Register(reg))
+
+	ok := fakeChecker{}
+	failing := fakeChecker{err: errors.New("not caught up")}
+
+	srv := NewServer("", reg, ok, failing, nil)
+	rr := httptest.NewRecorder()
+	srv.http.Handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	rr = httptest.NewRecorder()
+	srv.http.Handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rr.Code)
+
+	rr = httptest.NewRecorder()
+	srv.http.Handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+}
@@ -0,0 +1,255 @@
+// Package badger implements storage.Store on top of an embedded
+// BadgerDB instance, used as the indexer's hot tier for the recently
+// indexed range of slots.
+package badger
+
+import (
+	"context"
+	"encoding/binary"
+
+	badgerdb "github.com/dgraph-io/badger/v3"
+	"github.com/gagliardetto/solana-go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+)
+
+const (
+	slotPrefix   = 's'
+	txPrefix     = 't'
+	txSlotPrefix = 'i' // secondary index: txSlotPrefix|slot|signature -> signature
+)
+
+// Store is a storage.Store backed by an embedded BadgerDB database.
+type Store struct {
+	db  *badgerdb.DB
+	log *zap.Logger
+}
+
+// Open opens (creating if necessary) a BadgerDB database at dir.
+func Open(dir string, log *zap.Logger) (*Store, error) {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	opts := badgerdb.DefaultOptions(dir).WithLogger(nil)
+	db, err := badgerdb.Open(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "badger: open")
+	}
+	return &Store{db: db, log: log}, nil
+}
+
+// Close implements storage.Store.
+func (s *Store) Close() error {
+	return errors.Wrap(s.db.Close(), "badger: close")
+}
+
+func slotKey(slot uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = slotPrefix
+	binary.BigEndian.PutUint64(key[1:], slot)
+	return key
+}
+
+func txKey(sig solana.Signature) []byte {
+	key := make([]byte, 1+len(sig))
+	key[0] = txPrefix
+	copy(key[1:], sig[:])
+	return key
+}
+
+func txIndexKey(slot uint64, sig solana.Signature) []byte {
+	key := make([]byte, 1+8+len(sig))
+	key[0] = txSlotPrefix
+	binary.BigEndian.PutUint64(key[1:9], slot)
+	copy(key[9:], sig[:])
+	return key
+}
+
+// PutSlot implements storage.SlotStore.
+func (s *Store) PutSlot(_ context.Context, rec *storage.SlotRecord) error {
+	val, err := encodeSlot(rec)
+	if err != nil {
+		return errors.Wrap(err, "badger: encode slot")
+	}
+	err = s.db.Update(func(txn *badgerdb.Txn) error {
+		return txn.Set(slotKey(rec.Slot), val)
+	})
+	return errors.Wrap(err, "badger: put slot")
+}
+
+// GetSlot implements storage.SlotStore.
+func (s *Store) GetSlot(_ context.Context, slot uint64) (*storage.SlotRecord, error) {
+	var rec *storage.SlotRecord
+	err := s.db.View(func(txn *badgerdb.Txn) error {
+		item, err := txn.Get(slotKey(slot))
+		if err == badgerdb.ErrKeyNotFound {
+			return storage.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			rec, err = decodeSlot(val)
+			return err
+		})
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, errors.Wrap(err, "badger: get slot")
+	}
+	return rec, nil
+}
+
+// RangeSlots implements storage.SlotStore.
+func (s *Store) RangeSlots(_ context.Context, from, to uint64, fn func(*storage.SlotRecord) error) error {
+	err := s.db.View(func(txn *badgerdb.Txn) error {
+		opts := badgerdb.DefaultIteratorOptions
+		opts.Prefix = []byte{slotPrefix}
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Seek(slotKey(from)); it.ValidForPrefix(opts.Prefix); it.Next() {
+			item := it.Item()
+			slot := binary.BigEndian.Uint64(item.Key()[1:])
+			if slot > to {
+				break
+			}
+			var rec *storage.SlotRecord
+			if err := item.Value(func(val []byte) error {
+				var err error
+				rec, err = decodeSlot(val)
+				return err
+			}); err != nil {
+				return err
+			}
+			if err := fn(rec); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return errors.Wrap(err, "badger: range slots")
+}
+
+// DeleteSlot implements storage.SlotStore.
+func (s *Store) DeleteSlot(_ context.Context, slot uint64) error {
+	err := s.db.Update(func(txn *badgerdb.Txn) error {
+		return txn.Delete(slotKey(slot))
+	})
+	return errors.Wrap(err, "badger: delete slot")
+}
+
+// PutTx implements storage.TxStore.
+func (s *Store) PutTx(_ context.Context, rec *storage.TxRecord) error {
+	val, err := encodeTx(rec)
+	if err != nil {
+		return errors.Wrap(err, "badger: encode tx")
+	}
+	err = s.db.Update(func(txn *badgerdb.Txn) error {
+		if err := txn.Set(txKey(rec.Signature), val); err != nil {
+			return err
+		}
+		return txn.Set(txIndexKey(rec.Slot, rec.Signature), rec.Signature[:])
+	})
+	return errors.Wrap(err, "badger: put tx")
+}
+
+// GetTx implements storage.TxStore.
+func (s *Store) GetTx(_ context.Context, sig solana.Signature) (*storage.TxRecord, error) {
+	var rec *storage.TxRecord
+	err := s.db.View(func(txn *badgerdb.Txn) error {
+		item, err := txn.Get(txKey(sig))
+		if err == badgerdb.ErrKeyNotFound {
+			return storage.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			rec, err = decodeTx(val)
+			return err
+		})
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, errors.Wrap(err, "badger: get tx")
+	}
+	return rec, nil
+}
+
+// RangeTxs implements storage.TxStore.
+func (s *Store) RangeTxs(_ context.Context, fromSlot, toSlot uint64, fn func(*storage.TxRecord) error) error {
+	err := s.db.View(func(txn *badgerdb.Txn) error {
+		opts := badgerdb.DefaultIteratorOptions
+		opts.Prefix = []byte{txSlotPrefix}
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		lo := []byte{txSlotPrefix}
+		lo = binary.BigEndian.AppendUint64(lo, fromSlot)
+		for it.Seek(lo); it.ValidForPrefix(opts.Prefix); it.Next() {
+			key := it.Item().Key()
+			slot := binary.BigEndian.Uint64(key[1:9])
+			if slot > toSlot {
+				break
+			}
+			var sig solana.Signature
+			if err := it.Item().Value(func(val []byte) error {
+				copy(sig[:], val)
+				return nil
+			}); err != nil {
+				return err
+			}
+			item, err := txn.Get(txKey(sig))
+			if err != nil {
+				return err
+			}
+			var rec *storage.TxRecord
+			if err := item.Value(func(val []byte) error {
+				var err error
+				rec, err = decodeTx(val)
+				return err
+			}); err != nil {
+				return err
+			}
+			if err := fn(rec); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return errors.Wrap(err, "badger: range txs")
+}
+
+// DeleteTx implements storage.TxStore.
+func (s *Store) DeleteTx(_ context.Context, sig solana.Signature) error {
+	var slot uint64
+	err := s.db.Update(func(txn *badgerdb.Txn) error {
+		item, err := txn.Get(txKey(sig))
+		if err == badgerdb.ErrKeyNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := item.Value(func(val []byte) error {
+			rec, err := decodeTx(val)
+			if err != nil {
+				return err
+			}
+			slot = rec.Slot
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := txn.Delete(txKey(sig)); err != nil {
+			return err
+		}
+		return txn.Delete(txIndexKey(slot, sig))
+	})
+	return errors.Wrap(err, "badger: delete tx")
+}
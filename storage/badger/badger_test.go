@@ -0,0 +1,87 @@
+package badger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+)
+
+func openTestStore(t *testing.T) *Store {
+	t.Helper()
+	s, err := Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+	return s
+}
+
+func TestStore_SlotRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s := openTestStore(t)
+
+	_, err := s.GetSlot(ctx, 42)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+
+	rec := &storage.SlotRecord{Slot: 42, ParentSlot: 41, Commitment: storage.CommitmentFinalized, Data: []byte("block")}
+	require.NoError(t, s.PutSlot(ctx, rec))
+
+	got, err := s.GetSlot(ctx, 42)
+	require.NoError(t, err)
+	require.Equal(t, rec.Slot, got.Slot)
+	require.Equal(t, rec.Data, got.Data)
+
+	require.NoError(t, s.DeleteSlot(ctx, 42))
+	_, err = s.GetSlot(ctx, 42)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func TestStore_RangeSlots(t *testing.T) {
+	ctx := context.Background()
+	s := openTestStore(t)
+
+	for slot := uint64(1); slot <= 5; slot++ {
+		require.NoError(t, s.PutSlot(ctx, &storage.SlotRecord{Slot: slot}))
+	}
+
+	var got []uint64
+	require.NoError(t, s.RangeSlots(ctx, 2, 4, func(rec *storage.SlotRecord) error {
+		got = append(got, rec.Slot)
+		return nil
+	}))
+	require.Equal(t, []uint64{2, 3, 4}, got)
+}
+
+func TestStore_TxRoundTripAndRangeBySlot(t *testing.T) {
+	ctx := context.Background()
+	s := openTestStore(t)
+
+	sig1 := solana.SignatureFromBytes(bytesOf(1))
+	sig2 := solana.SignatureFromBytes(bytesOf(2))
+
+	require.NoError(t, s.PutTx(ctx, &storage.TxRecord{Signature: sig1, Slot: 10, Data: []byte("a")}))
+	require.NoError(t, s.PutTx(ctx, &storage.TxRecord{Signature: sig2, Slot: 11, Data: []byte("b")}))
+
+	got, err := s.GetTx(ctx, sig1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("a"), got.Data)
+
+	var sigs []solana.Signature
+	require.NoError(t, s.RangeTxs(ctx, 10, 11, func(rec *storage.TxRecord) error {
+		sigs = append(sigs, rec.Signature)
+		return nil
+	}))
+	require.ElementsMatch(t, []solana.Signature{sig1, sig2}, sigs)
+
+	require.NoError(t, s.DeleteTx(ctx, sig1))
+	_, err = s.GetTx(ctx, sig1)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func bytesOf(b byte) []byte {
+	out := make([]byte, 64)
+	out[0] = b
+	return out
+}
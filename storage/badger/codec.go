@@ -0,0 +1,40 @@
+package badger
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+)
+
+func encodeSlot(rec *storage.SlotRecord) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(rec); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeSlot(val []byte) (*storage.SlotRecord, error) {
+	var rec storage.SlotRecord
+	if err := gob.NewDecoder(bytes.NewReader(val)).Decode(&rec); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func encodeTx(rec *storage.TxRecord) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(rec); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeTx(val []byte) (*storage.TxRecord, error) {
+	var rec storage.TxRecord
+	if err := gob.NewDecoder(bytes.NewReader(val)).Decode(&rec); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
@@ -0,0 +1,133 @@
+package cold
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+)
+
+// fakeObjectAPI is an in-memory objectAPI used so cold store tests don't
+// need a real S3-compatible endpoint.
+type fakeObjectAPI struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func newFakeObjectAPI() *fakeObjectAPI {
+	return &fakeObjectAPI{objects: make(map[string][]byte)}
+}
+
+func (f *fakeObjectAPI) PutObject(_ context.Context, _, object string, reader io.Reader, _ int64, _ minio.PutObjectOptions) (minio.UploadInfo, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.objects[object] = data
+	return minio.UploadInfo{Key: object, Size: int64(len(data))}, nil
+}
+
+func (f *fakeObjectAPI) GetObject(_ context.Context, _, object string, _ minio.GetObjectOptions) (io.ReadCloser, error) {
+	f.mu.Lock()
+	data, ok := f.objects[object]
+	f.mu.Unlock()
+	if !ok {
+		return nil, minio.ErrorResponse{Code: "NoSuchKey", Message: "not found"}
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (f *fakeObjectAPI) StatObject(_ context.Context, _, object string, _ minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	f.mu.Lock()
+	data, ok := f.objects[object]
+	f.mu.Unlock()
+	if !ok {
+		return minio.ObjectInfo{}, minio.ErrorResponse{Code: "NoSuchKey", Message: "not found"}
+	}
+	return minio.ObjectInfo{Key: object, Size: int64(len(data))}, nil
+}
+
+func (f *fakeObjectAPI) RemoveObject(_ context.Context, _, object string, _ minio.RemoveObjectOptions) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.objects, object)
+	return nil
+}
+
+func newTestStore(t *testing.T) (*Store, *fakeObjectAPI) {
+	t.Helper()
+	api := newFakeObjectAPI()
+	s := &Store{client: api, bucket: "archive", prefix: "mainnet/", manifest: newManifest(), log: zap.NewNop()}
+	return s, api
+}
+
+func TestStore_WriteBatchAndGetSlot(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStore(t)
+
+	sig := solana.SignatureFromBytes(bytesOf(1))
+	slots := []*storage.SlotRecord{
+		{Slot: 100, Commitment: storage.CommitmentFinalized, Data: []byte("s100")},
+		{Slot: 101, Commitment: storage.CommitmentFinalized, Data: []byte("s101")},
+	}
+	txs := []*storage.TxRecord{{Signature: sig, Slot: 100, Data: []byte("tx")}}
+
+	require.NoError(t, s.WriteBatch(ctx, slots, txs))
+
+	got, err := s.GetSlot(ctx, 101)
+	require.NoError(t, err)
+	require.Equal(t, []byte("s101"), got.Data)
+
+	_, err = s.GetSlot(ctx, 999)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+
+	gotTx, err := s.GetTx(ctx, sig)
+	require.NoError(t, err)
+	require.Equal(t, []byte("tx"), gotTx.Data)
+}
+
+func TestStore_RangeSlotsAcrossBatches(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStore(t)
+
+	require.NoError(t, s.WriteBatch(ctx, []*storage.SlotRecord{{Slot: 1}, {Slot: 2}}, nil))
+	require.NoError(t, s.WriteBatch(ctx, []*storage.SlotRecord{{Slot: 3}, {Slot: 4}}, nil))
+
+	var got []uint64
+	require.NoError(t, s.RangeSlots(ctx, 2, 3, func(rec *storage.SlotRecord) error {
+		got = append(got, rec.Slot)
+		return nil
+	}))
+	require.Equal(t, []uint64{2, 3}, got)
+}
+
+func TestStore_ManifestPersistsAcrossOpen(t *testing.T) {
+	ctx := context.Background()
+	s, api := newTestStore(t)
+	require.NoError(t, s.WriteBatch(ctx, []*storage.SlotRecord{{Slot: 5}}, nil))
+
+	reopened := &Store{client: api, bucket: "archive", prefix: "mainnet/", log: zap.NewNop()}
+	m, err := reopened.loadManifest(ctx)
+	require.NoError(t, err)
+	reopened.manifest = m
+
+	got, err := reopened.GetSlot(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), got.Slot)
+}
+
+func bytesOf(b byte) []byte {
+	out := make([]byte, 64)
+	out[0] = b
+	return out
+}
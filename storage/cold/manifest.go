@@ -0,0 +1,120 @@
+package cold
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// manifestKey is the well-known object under which the batch index is
+// kept, one per bucket/prefix.
+const manifestKey = "manifest.json"
+
+// batchMeta describes one compressed batch object.
+type batchMeta struct {
+	StartSlot uint64 `json:"start_slot"`
+	EndSlot   uint64 `json:"end_slot"`
+	ObjectKey string `json:"object_key"`
+	SlotCount int    `json:"slot_count"`
+	TxCount   int    `json:"tx_count"`
+}
+
+// txEntry records which batch object holds a given transaction.
+type txEntry struct {
+	Signature string `json:"signature"` // base58
+	ObjectKey string `json:"object_key"`
+}
+
+// manifestDoc is the JSON-serializable form of a manifest.
+type manifestDoc struct {
+	Batches []batchMeta `json:"batches"`
+	Txs     []txEntry   `json:"txs"`
+}
+
+// manifest is the in-memory, range-queryable index over archived batches.
+// It is safe for concurrent use.
+type manifest struct {
+	mu      sync.RWMutex
+	batches []batchMeta // sorted by StartSlot, non-overlapping
+	txIndex map[solana.Signature]string
+}
+
+func newManifest() *manifest {
+	return &manifest{txIndex: make(map[solana.Signature]string)}
+}
+
+func decodeManifest(data []byte) (*manifest, error) {
+	var doc manifestDoc
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+	}
+	m := newManifest()
+	m.batches = doc.Batches
+	for _, tx := range doc.Txs {
+		sig, err := solana.SignatureFromBase58(tx.Signature)
+		if err != nil {
+			return nil, err
+		}
+		m.txIndex[sig] = tx.ObjectKey
+	}
+	return m, nil
+}
+
+func (m *manifest) encode() ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	doc := manifestDoc{Batches: m.batches}
+	for sig, key := range m.txIndex {
+		doc.Txs = append(doc.Txs, txEntry{Signature: sig.String(), ObjectKey: key})
+	}
+	return json.Marshal(doc)
+}
+
+// batchFor returns the batch covering slot, if any.
+func (m *manifest) batchFor(slot uint64) (batchMeta, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	i := sort.Search(len(m.batches), func(i int) bool {
+		return m.batches[i].EndSlot >= slot
+	})
+	if i < len(m.batches) && m.batches[i].StartSlot <= slot && slot <= m.batches[i].EndSlot {
+		return m.batches[i], true
+	}
+	return batchMeta{}, false
+}
+
+// batchesInRange returns every batch overlapping [from, to], in order.
+func (m *manifest) batchesInRange(from, to uint64) []batchMeta {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var out []batchMeta
+	for _, b := range m.batches {
+		if b.EndSlot < from || b.StartSlot > to {
+			continue
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+func (m *manifest) objectForTx(sig solana.Signature) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	key, ok := m.txIndex[sig]
+	return key, ok
+}
+
+// addBatch records a newly written batch object and its transactions.
+func (m *manifest) addBatch(meta batchMeta, sigs []solana.Signature) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.batches = append(m.batches, meta)
+	sort.Slice(m.batches, func(i, j int) bool { return m.batches[i].StartSlot < m.batches[j].StartSlot })
+	for _, sig := range sigs {
+		m.txIndex[sig] = meta.ObjectKey
+	}
+}
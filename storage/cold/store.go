@@ -0,0 +1,295 @@
+// Package cold implements the indexer's archival storage tier: finalized
+// slots older than the hot tier's retention window are batched, compressed
+// with zstd, and written as objects to an S3-compatible bucket, indexed by
+// a manifest for range lookups.
+package cold
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"io"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/klauspost/compress/zstd"
+	"github.com/minio/minio-go/v7"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+)
+
+// objectAPI is the subset of *minio.Client the cold store depends on, so
+// tests can substitute an in-memory fake without a real S3 endpoint.
+type objectAPI interface {
+	PutObject(ctx context.Context, bucket, object string, reader io.Reader, size int64, opts minio.PutObjectOptions) (minio.UploadInfo, error)
+	GetObject(ctx context.Context, bucket, object string, opts minio.GetObjectOptions) (io.ReadCloser, error)
+	StatObject(ctx context.Context, bucket, object string, opts minio.StatObjectOptions) (minio.ObjectInfo, error)
+	RemoveObject(ctx context.Context, bucket, object string, opts minio.RemoveObjectOptions) error
+}
+
+// clientAdapter narrows a real *minio.Client down to objectAPI: *minio.Object
+// implements io.ReadCloser, it just isn't declared as one.
+type clientAdapter struct{ *minio.Client }
+
+func (c clientAdapter) GetObject(ctx context.Context, bucket, object string, opts minio.GetObjectOptions) (io.ReadCloser, error) {
+	return c.Client.GetObject(ctx, bucket, object, opts)
+}
+
+// batch is the decompressed payload of a single archive object.
+type batch struct {
+	Slots []*storage.SlotRecord
+	Txs   []*storage.TxRecord
+}
+
+// Store is a storage.SlotStore/storage.TxStore backed by zstd-compressed
+// batch objects in an S3-compatible bucket. It is append-only: individual
+// records are written via WriteBatch, not PutSlot/PutTx.
+type Store struct {
+	client objectAPI
+	bucket string
+	prefix string
+	log    *zap.Logger
+
+	manifest *manifest
+}
+
+// Open connects Store to an existing bucket and loads its manifest. The
+// bucket must already exist; prefix namespaces all objects Store writes
+// (e.g. "mainnet/").
+func Open(ctx context.Context, client *minio.Client, bucket, prefix string, log *zap.Logger) (*Store, error) {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	s := &Store{client: clientAdapter{client}, bucket: bucket, prefix: prefix, log: log}
+	m, err := s.loadManifest(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "cold: load manifest")
+	}
+	s.manifest = m
+	return s, nil
+}
+
+func (s *Store) key(name string) string {
+	return s.prefix + name
+}
+
+func isNotFound(err error) bool {
+	var merr minio.ErrorResponse
+	if errors.As(err, &merr) {
+		return merr.Code == "NoSuchKey" || merr.Code == "NoSuchBucket"
+	}
+	return minio.ToErrorResponse(err).Code == "NoSuchKey"
+}
+
+func (s *Store) loadManifest(ctx context.Context) (*manifest, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, s.key(manifestKey), minio.GetObjectOptions{})
+	if err != nil {
+		if isNotFound(err) {
+			return newManifest(), nil
+		}
+		return nil, err
+	}
+	defer obj.Close()
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		if isNotFound(err) {
+			return newManifest(), nil
+		}
+		return nil, err
+	}
+	if len(data) == 0 {
+		return newManifest(), nil
+	}
+	return decodeManifest(data)
+}
+
+func (s *Store) saveManifest(ctx context.Context) error {
+	data, err := s.manifest.encode()
+	if err != nil {
+		return err
+	}
+	_, err = s.client.PutObject(ctx, s.bucket, s.key(manifestKey), bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: "application/json",
+	})
+	return err
+}
+
+// WriteBatch compresses and uploads one archive object covering slots
+// [slots[0].Slot, slots[len-1].Slot] together with their transactions,
+// then records the object in the manifest. slots must be sorted ascending
+// and already finalized; callers (the tiered store's migrator) are
+// responsible for only archiving immutable ranges.
+func (s *Store) WriteBatch(ctx context.Context, slots []*storage.SlotRecord, txs []*storage.TxRecord) error {
+	if len(slots) == 0 {
+		return nil
+	}
+	start, end := slots[0].Slot, slots[len(slots)-1].Slot
+
+	var raw bytes.Buffer
+	if err := gob.NewEncoder(&raw).Encode(&batch{Slots: slots, Txs: txs}); err != nil {
+		return errors.Wrap(err, "cold: encode batch")
+	}
+
+	enc, err := zstd.NewWriter(nil)
+	if err != nil {
+		return errors.Wrap(err, "cold: new zstd encoder")
+	}
+	defer enc.Close()
+	compressed := enc.EncodeAll(raw.Bytes(), nil)
+
+	objectKey := s.key(fmt.Sprintf("slots/%020d-%020d.zst", start, end))
+	_, err = s.client.PutObject(ctx, s.bucket, objectKey, bytes.NewReader(compressed), int64(len(compressed)), minio.PutObjectOptions{
+		ContentType: "application/zstd",
+	})
+	if err != nil {
+		return errors.Wrap(err, "cold: put batch object")
+	}
+
+	sigs := make([]solana.Signature, len(txs))
+	for i, tx := range txs {
+		sigs[i] = tx.Signature
+	}
+	s.manifest.addBatch(batchMeta{
+		StartSlot: start,
+		EndSlot:   end,
+		ObjectKey: objectKey,
+		SlotCount: len(slots),
+		TxCount:   len(txs),
+	}, sigs)
+
+	if err := s.saveManifest(ctx); err != nil {
+		return errors.Wrap(err, "cold: save manifest")
+	}
+	s.log.Info("archived slot batch",
+		zap.Uint64("start_slot", start), zap.Uint64("end_slot", end),
+		zap.Int("slots", len(slots)), zap.Int("txs", len(txs)))
+	return nil
+}
+
+func (s *Store) getBatch(ctx context.Context, objectKey string) (*batch, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, objectKey, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+	compressed, err := io.ReadAll(obj)
+	if err != nil {
+		return nil, err
+	}
+	dec, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer dec.Close()
+	raw, err := dec.DecodeAll(compressed, nil)
+	if err != nil {
+		return nil, err
+	}
+	var b batch
+	if err := gob.NewDecoder(bytes.NewReader(raw)).Decode(&b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// GetSlot implements storage.SlotStore.
+func (s *Store) GetSlot(ctx context.Context, slot uint64) (*storage.SlotRecord, error) {
+	meta, ok := s.manifest.batchFor(slot)
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	b, err := s.getBatch(ctx, meta.ObjectKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "cold: get slot")
+	}
+	for _, rec := range b.Slots {
+		if rec.Slot == slot {
+			return rec, nil
+		}
+	}
+	return nil, storage.ErrNotFound
+}
+
+// RangeSlots implements storage.SlotStore.
+func (s *Store) RangeSlots(ctx context.Context, from, to uint64, fn func(*storage.SlotRecord) error) error {
+	for _, meta := range s.manifest.batchesInRange(from, to) {
+		b, err := s.getBatch(ctx, meta.ObjectKey)
+		if err != nil {
+			return errors.Wrap(err, "cold: range slots")
+		}
+		for _, rec := range b.Slots {
+			if rec.Slot < from || rec.Slot > to {
+				continue
+			}
+			if err := fn(rec); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteSlot is unsupported on the cold tier: archived batches are
+// immutable and are never edited in place.
+func (s *Store) DeleteSlot(context.Context, uint64) error {
+	return errors.New("cold: DeleteSlot not supported, archive batches are immutable")
+}
+
+// GetTx implements storage.TxStore.
+func (s *Store) GetTx(ctx context.Context, sig solana.Signature) (*storage.TxRecord, error) {
+	objectKey, ok := s.manifest.objectForTx(sig)
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	b, err := s.getBatch(ctx, objectKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "cold: get tx")
+	}
+	for _, rec := range b.Txs {
+		if rec.Signature == sig {
+			return rec, nil
+		}
+	}
+	return nil, storage.ErrNotFound
+}
+
+// RangeTxs implements storage.TxStore.
+func (s *Store) RangeTxs(ctx context.Context, fromSlot, toSlot uint64, fn func(*storage.TxRecord) error) error {
+	for _, meta := range s.manifest.batchesInRange(fromSlot, toSlot) {
+		b, err := s.getBatch(ctx, meta.ObjectKey)
+		if err != nil {
+			return errors.Wrap(err, "cold: range txs")
+		}
+		for _, rec := range b.Txs {
+			if rec.Slot < fromSlot || rec.Slot > toSlot {
+				continue
+			}
+			if err := fn(rec); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteTx is unsupported on the cold tier; see DeleteSlot.
+func (s *Store) DeleteTx(context.Context, solana.Signature) error {
+	return errors.New("cold: DeleteTx not supported, archive batches are immutable")
+}
+
+// PutSlot is unsupported: records reach the cold tier only through
+// WriteBatch, driven by the tiered store's background migrator.
+func (s *Store) PutSlot(context.Context, *storage.SlotRecord) error {
+	return errors.New("cold: PutSlot not supported, use WriteBatch")
+}
+
+// PutTx is unsupported; see PutSlot.
+func (s *Store) PutTx(context.Context, *storage.TxRecord) error {
+	return errors.New("cold: PutTx not supported, use WriteBatch")
+}
+
+// Close implements storage.Store. The cold tier holds no live connections
+// beyond the shared minio client, so Close is a no-op.
+func (s *Store) Close() error { return nil }
@@ -0,0 +1,81 @@
+// Package storage defines the indexer's persistence layer: a tiered
+// key/value abstraction over slots and transactions, keyed the way the
+// rest of the indexer already addresses them (slot number, tx signature).
+//
+// Implementations live in subpackages (badger for the hot tier, cold for
+// the S3-compatible archival tier) and are composed by tiered.Store into
+// the Store the rest of the pipeline depends on.
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// ErrNotFound is returned by GetSlot/GetTx when the requested key has no
+// record in the store being queried. Tiered stores use it to decide
+// whether to fall through to the next tier.
+var ErrNotFound = errors.New("storage: not found")
+
+// SlotRecord is the persisted representation of a processed slot.
+type SlotRecord struct {
+	Slot       uint64
+	Blockhash  solana.Hash
+	ParentSlot uint64
+	ParentHash solana.Hash
+	Commitment Commitment
+	Data       []byte // opaque, caller-encoded block payload
+}
+
+// TxRecord is the persisted representation of a single transaction.
+type TxRecord struct {
+	Signature solana.Signature
+	Slot      uint64
+	Data      []byte // opaque, caller-encoded transaction payload
+}
+
+// Commitment mirrors Solana's commitment levels, ordered from least to
+// most final so callers can compare with <.
+type Commitment uint8
+
+const (
+	CommitmentProcessed Commitment = iota
+	CommitmentConfirmed
+	CommitmentFinalized
+)
+
+// SlotStore persists and serves SlotRecords.
+type SlotStore interface {
+	// PutSlot inserts or overwrites the record for rec.Slot.
+	PutSlot(ctx context.Context, rec *SlotRecord) error
+	// GetSlot returns ErrNotFound if no record exists for slot.
+	GetSlot(ctx context.Context, slot uint64) (*SlotRecord, error)
+	// RangeSlots calls fn for every stored slot in [from, to], in
+	// ascending order, stopping at the first error fn returns.
+	RangeSlots(ctx context.Context, from, to uint64, fn func(*SlotRecord) error) error
+	// DeleteSlot removes the record for slot, if any.
+	DeleteSlot(ctx context.Context, slot uint64) error
+}
+
+// TxStore persists and serves TxRecords.
+type TxStore interface {
+	// PutTx inserts or overwrites the record for rec.Signature.
+	PutTx(ctx context.Context, rec *TxRecord) error
+	// GetTx returns ErrNotFound if no record exists for sig.
+	GetTx(ctx context.Context, sig solana.Signature) (*TxRecord, error)
+	// RangeTxs calls fn for every stored transaction belonging to a slot
+	// in [fromSlot, toSlot], stopping at the first error fn returns.
+	RangeTxs(ctx context.Context, fromSlot, toSlot uint64, fn func(*TxRecord) error) error
+	// DeleteTx removes the record for sig, if any.
+	DeleteTx(ctx context.Context, sig solana.Signature) error
+}
+
+// Store is the full persistence surface the indexer pipeline depends on.
+type Store interface {
+	SlotStore
+	TxStore
+	// Close releases any resources held by the store.
+	Close() error
+}
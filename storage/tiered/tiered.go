@@ -0,0 +1,252 @@
+// Package tiered composes a hot storage.Store with a cold archival store
+// into a single storage.Store: reads fall through from hot to cold, and a
+// background loop migrates finalized slots older than a retention window
+// out of the hot tier once they've been archived.
+package tiered
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+)
+
+// coldStore is the subset of cold.Store the tiered store depends on,
+// narrowed so callers can substitute a fake in tests.
+type coldStore interface {
+	storage.SlotStore
+	storage.TxStore
+	WriteBatch(ctx context.Context, slots []*storage.SlotRecord, txs []*storage.TxRecord) error
+}
+
+// Config controls retention and batching of the background migrator.
+type Config struct {
+	// RetentionSlots is how many slots behind the observed tip stay in
+	// the hot tier. Older finalized slots are eligible for migration.
+	RetentionSlots uint64
+	// BatchSize is how many slots are grouped into one cold archive
+	// object per migration batch.
+	BatchSize uint64
+	// MigrationInterval is how often the background migrator runs.
+	MigrationInterval time.Duration
+}
+
+// Store is a storage.Store that tiers reads across a hot and cold store.
+type Store struct {
+	hot  storage.Store
+	cold coldStore
+	cfg  Config
+	log  *zap.Logger
+
+	tipSlot      atomic.Uint64
+	lastMigrated atomic.Uint64
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+// New composes hot and cold into a tiered Store. Call Start to begin
+// background migration.
+func New(hot storage.Store, cold coldStore, cfg Config, log *zap.Logger) *Store {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = 1000
+	}
+	if cfg.MigrationInterval == 0 {
+		cfg.MigrationInterval = time.Minute
+	}
+	doneCh := make(chan struct{})
+	close(doneCh) // closed until Start is called, so Close is a no-op without it
+	return &Store{
+		hot:    hot,
+		cold:   cold,
+		cfg:    cfg,
+		log:    log,
+		stopCh: make(chan struct{}),
+		doneCh: doneCh,
+	}
+}
+
+// Advance records the latest finalized slot observed from the RPC tip,
+// which drives the retention-window calculation. The fetcher calls this
+// as it ingests new finalized slots.
+func (s *Store) Advance(tipSlot uint64) {
+	for {
+		cur := s.tipSlot.Load()
+		if tipSlot <= cur || s.tipSlot.CompareAndSwap(cur, tipSlot) {
+			return
+		}
+	}
+}
+
+// Start launches the background migration loop. It returns immediately;
+// call Close to stop it. Must not be called more than once.
+func (s *Store) Start(ctx context.Context) {
+	s.doneCh = make(chan struct{})
+	go s.migrateLoop(ctx)
+}
+
+func (s *Store) migrateLoop(ctx context.Context) {
+	defer close(s.doneCh)
+	ticker := time.NewTicker(s.cfg.MigrationInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			if err := s.migrateOnce(ctx); err != nil {
+				s.log.Error("slot migration failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// migrateOnce migrates every newly eligible, finalized slot from hot to
+// cold, from just after the last migrated slot up to tip minus
+// cfg.RetentionSlots, in cfg.BatchSize groups.
+func (s *Store) migrateOnce(ctx context.Context) error {
+	tip := s.tipSlot.Load()
+	if tip <= s.cfg.RetentionSlots {
+		return nil
+	}
+	boundary := tip - s.cfg.RetentionSlots
+	from := s.lastMigrated.Load()
+	if from > 0 {
+		from++
+	}
+	if from > boundary {
+		return nil
+	}
+
+	for batchStart := from; batchStart <= boundary; batchStart += s.cfg.BatchSize {
+		batchEnd := batchStart + s.cfg.BatchSize - 1
+		if batchEnd > boundary {
+			batchEnd = boundary
+		}
+
+		var slots []*storage.SlotRecord
+		err := s.hot.RangeSlots(ctx, batchStart, batchEnd, func(rec *storage.SlotRecord) error {
+			if rec.Commitment != storage.CommitmentFinalized {
+				return nil
+			}
+			slots = append(slots, rec)
+			return nil
+		})
+		if err != nil {
+			return errors.Wrap(err, "tiered: collect slots for migration")
+		}
+		if len(slots) == 0 {
+			s.lastMigrated.Store(batchEnd)
+			continue
+		}
+
+		var txs []*storage.TxRecord
+		err = s.hot.RangeTxs(ctx, batchStart, batchEnd, func(rec *storage.TxRecord) error {
+			txs = append(txs, rec)
+			return nil
+		})
+		if err != nil {
+			return errors.Wrap(err, "tiered: collect txs for migration")
+		}
+
+		if err := s.cold.WriteBatch(ctx, slots, txs); err != nil {
+			return errors.Wrap(err, "tiered: write cold batch")
+		}
+
+		for _, rec := range slots {
+			if err := s.hot.DeleteSlot(ctx, rec.Slot); err != nil {
+				return errors.Wrap(err, "tiered: evict migrated slot")
+			}
+		}
+		for _, rec := range txs {
+			if err := s.hot.DeleteTx(ctx, rec.Signature); err != nil {
+				return errors.Wrap(err, "tiered: evict migrated tx")
+			}
+		}
+		s.lastMigrated.Store(batchEnd)
+	}
+	return nil
+}
+
+// Close stops the background migrator and blocks until it has exited.
+func (s *Store) Close() error {
+	s.stopOnce.Do(func() { close(s.stopCh) })
+	<-s.doneCh
+	return s.hot.Close()
+}
+
+// PutSlot implements storage.SlotStore. New records always land in the
+// hot tier; they migrate to cold once finalized and past retention.
+func (s *Store) PutSlot(ctx context.Context, rec *storage.SlotRecord) error {
+	return s.hot.PutSlot(ctx, rec)
+}
+
+// GetSlot implements storage.SlotStore, falling back to cold on a hot miss.
+func (s *Store) GetSlot(ctx context.Context, slot uint64) (*storage.SlotRecord, error) {
+	rec, err := s.hot.GetSlot(ctx, slot)
+	if err == nil {
+		return rec, nil
+	}
+	if !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+	return s.cold.GetSlot(ctx, slot)
+}
+
+// RangeSlots implements storage.SlotStore. Since migration evicts slots
+// from hot once archived, cold and hot never overlap: emit cold's
+// (older) slots first, then hot's.
+func (s *Store) RangeSlots(ctx context.Context, from, to uint64, fn func(*storage.SlotRecord) error) error {
+	if err := s.cold.RangeSlots(ctx, from, to, fn); err != nil {
+		return err
+	}
+	return s.hot.RangeSlots(ctx, from, to, fn)
+}
+
+// DeleteSlot implements storage.SlotStore against the hot tier; archived
+// batches in cold are immutable (see cold.Store.DeleteSlot).
+func (s *Store) DeleteSlot(ctx context.Context, slot uint64) error {
+	return s.hot.DeleteSlot(ctx, slot)
+}
+
+// PutTx implements storage.TxStore against the hot tier.
+func (s *Store) PutTx(ctx context.Context, rec *storage.TxRecord) error {
+	return s.hot.PutTx(ctx, rec)
+}
+
+// GetTx implements storage.TxStore, falling back to cold on a hot miss.
+func (s *Store) GetTx(ctx context.Context, sig solana.Signature) (*storage.TxRecord, error) {
+	rec, err := s.hot.GetTx(ctx, sig)
+	if err == nil {
+		return rec, nil
+	}
+	if !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+	return s.cold.GetTx(ctx, sig)
+}
+
+// RangeTxs implements storage.TxStore; see RangeSlots for ordering.
+func (s *Store) RangeTxs(ctx context.Context, fromSlot, toSlot uint64, fn func(*storage.TxRecord) error) error {
+	if err := s.cold.RangeTxs(ctx, fromSlot, toSlot, fn); err != nil {
+		return err
+	}
+	return s.hot.RangeTxs(ctx, fromSlot, toSlot, fn)
+}
+
+// DeleteTx implements storage.TxStore against the hot tier.
+func (s *Store) DeleteTx(ctx context.Context, sig solana.Signature) error {
+	return s.hot.DeleteTx(ctx, sig)
+}
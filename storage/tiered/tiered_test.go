@@ -0,0 +1,154 @@
+package tiered
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Layr-Labs/solana-chain-indexer/storage"
+	"github.com/Layr-Labs/solana-chain-indexer/storage/badger"
+)
+
+// fakeCold is an in-memory coldStore used to test tiered.Store's fallback
+// and migration behavior without a real archival backend.
+type fakeCold struct {
+	slots map[uint64]*storage.SlotRecord
+	txs   map[solana.Signature]*storage.TxRecord
+}
+
+func newFakeCold() *fakeCold {
+	return &fakeCold{slots: map[uint64]*storage.SlotRecord{}, txs: map[solana.Signature]*storage.TxRecord{}}
+}
+
+func (f *fakeCold) PutSlot(context.Context, *storage.SlotRecord) error { return storage.ErrNotFound }
+func (f *fakeCold) PutTx(context.Context, *storage.TxRecord) error     { return storage.ErrNotFound }
+
+func (f *fakeCold) GetSlot(_ context.Context, slot uint64) (*storage.SlotRecord, error) {
+	rec, ok := f.slots[slot]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return rec, nil
+}
+
+func (f *fakeCold) RangeSlots(_ context.Context, from, to uint64, fn func(*storage.SlotRecord) error) error {
+	for slot := from; slot <= to; slot++ {
+		if rec, ok := f.slots[slot]; ok {
+			if err := fn(rec); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (f *fakeCold) DeleteSlot(_ context.Context, slot uint64) error {
+	delete(f.slots, slot)
+	return nil
+}
+
+func (f *fakeCold) GetTx(_ context.Context, sig solana.Signature) (*storage.TxRecord, error) {
+	rec, ok := f.txs[sig]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return rec, nil
+}
+
+func (f *fakeCold) RangeTxs(_ context.Context, fromSlot, toSlot uint64, fn func(*storage.TxRecord) error) error {
+	for _, rec := range f.txs {
+		if rec.Slot < fromSlot || rec.Slot > toSlot {
+			continue
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeCold) DeleteTx(_ context.Context, sig solana.Signature) error {
+	delete(f.txs, sig)
+	return nil
+}
+
+func (f *fakeCold) WriteBatch(_ context.Context, slots []*storage.SlotRecord, txs []*storage.TxRecord) error {
+	for _, rec := range slots {
+		f.slots[rec.Slot] = rec
+	}
+	for _, rec := range txs {
+		f.txs[rec.Signature] = rec
+	}
+	return nil
+}
+
+func newTestStore(t *testing.T, cfg Config) (*Store, *fakeCold) {
+	t.Helper()
+	hot, err := badger.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	cold := newFakeCold()
+	s := New(hot, cold, cfg, nil)
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+	return s, cold
+}
+
+func TestStore_GetFallsBackToCold(t *testing.T) {
+	ctx := context.Background()
+	s, cold := newTestStore(t, Config{})
+
+	cold.slots[5] = &storage.SlotRecord{Slot: 5, Data: []byte("archived")}
+
+	got, err := s.GetSlot(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, []byte("archived"), got.Data)
+
+	_, err = s.GetSlot(ctx, 999)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func TestStore_MigrateOnceMovesFinalizedSlotsPastRetention(t *testing.T) {
+	ctx := context.Background()
+	s, cold := newTestStore(t, Config{RetentionSlots: 10, BatchSize: 5})
+
+	for slot := uint64(1); slot <= 20; slot++ {
+		require.NoError(t, s.PutSlot(ctx, &storage.SlotRecord{Slot: slot, Commitment: storage.CommitmentFinalized}))
+	}
+	s.Advance(25) // boundary = 25 - 10 = 15
+
+	require.NoError(t, s.migrateOnce(ctx))
+
+	for slot := uint64(1); slot <= 15; slot++ {
+		require.Contains(t, cold.slots, slot)
+		_, err := s.hot.GetSlot(ctx, slot)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	}
+	for slot := uint64(16); slot <= 20; slot++ {
+		require.NotContains(t, cold.slots, slot)
+		_, err := s.hot.GetSlot(ctx, slot)
+		require.NoError(t, err)
+	}
+}
+
+func TestStore_MigrateOnceSkipsUnfinalizedSlots(t *testing.T) {
+	ctx := context.Background()
+	s, cold := newTestStore(t, Config{RetentionSlots: 0, BatchSize: 5})
+
+	require.NoError(t, s.PutSlot(ctx, &storage.SlotRecord{Slot: 1, Commitment: storage.CommitmentConfirmed}))
+	s.Advance(1)
+
+	require.NoError(t, s.migrateOnce(ctx))
+
+	require.NotContains(t, cold.slots, uint64(1))
+	_, err := s.hot.GetSlot(ctx, 1)
+	require.NoError(t, err)
+}
+
+func TestStore_StartStop(t *testing.T) {
+	s, _ := newTestStore(t, Config{MigrationInterval: time.Millisecond})
+	ctx, cancel := context.WithCancel(context.Background())
+	s.Start(ctx)
+	cancel()
+}